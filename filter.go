@@ -1,4 +1,4 @@
-package query
+package webquery
 
 import (
 	"errors"
@@ -13,18 +13,9 @@ type IQuery interface {
 	Query(string) string
 }
 
-var sqlAntiInjectRules = []string{`%`, `#`, `-`, `'`, `"`, "/", "*"}
-
 type ParseFunc func(f Filter, c IQuery) error
 type BindFunc func(f Filter, db *gorm.DB) *gorm.DB
 
-func sqlAntiInject(sql string) string {
-	for _, rule := range sqlAntiInjectRules {
-		sql = strings.ReplaceAll(sql, rule, "")
-	}
-	return strings.TrimSpace(sql)
-}
-
 func parseValue(f Filter, c IQuery) (string, error) {
 	if f.GetKey() == "" {
 		return "", errors.New("filter key is empty")
@@ -119,7 +110,6 @@ func (f *StringFilter) Parse(c IQuery) error {
 	if err != nil || value == "" {
 		return err
 	}
-	value = sqlAntiInject(value)
 	f.SetValue(value)
 	return nil
 }
@@ -212,7 +202,6 @@ func (f *StringArrayFilter) Parse(c IQuery) error {
 	if err != nil || value == "" {
 		return err
 	}
-	value = sqlAntiInject(value)
 	f.SetValue(strings.Split(value, f.GetSep()))
 	return nil
 }
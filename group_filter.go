@@ -0,0 +1,118 @@
+package webquery
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// 分组过滤器的组合方式
+const (
+	CombinatorAnd = "AND"
+	CombinatorOr  = "OR"
+)
+
+// Or 构造一个以 OR 组合子过滤器的 GroupFilter，外层仍与其它顶层 Filter 以 AND 拼接，
+// 例如 Or(&StringFilter{...}, &StringFilter{...}) 生成 (a LIKE ? OR b LIKE ?)
+func Or(filters ...Filter) *GroupFilter {
+	return &GroupFilter{Children: filters, Combinator: CombinatorOr}
+}
+
+// And 构造一个以 AND 组合子过滤器的 GroupFilter，常用于需要整体取反（Not）的场景
+func And(filters ...Filter) *GroupFilter {
+	return &GroupFilter{Children: filters, Combinator: CombinatorAnd}
+}
+
+// GroupFilter 把多个 Filter（含嵌套的 GroupFilter）作为一个整体用 AND/OR 组合。
+// 每个子过滤器先在一个独立的 *gorm.DB 会话上 Bind 出完整的子语句，再把这个
+// *gorm.DB 作为条件值传给外层 Where/Or/Not —— gorm 的 BuildCondition 对
+// *gorm.DB 有专门处理（取其 WHERE 子句并按需加括号），从而正确表达形如
+// (email LIKE ? OR phone LIKE ?) 这类嵌套布尔条件。注意：bare
+// func(*gorm.DB) *gorm.DB 只有 Scopes 才认，Where/Or/Not 并不支持，会被当作
+// 普通条件值落入主键等值查询，因此这里不能沿用该写法
+type GroupFilter struct {
+	Key        string   // 分组标识，仅用于定位来源字段，不对应具体请求参数
+	Children   []Filter // 子过滤器，可递归嵌套 GroupFilter
+	Combinator string   // AND（默认）或 OR
+	Not        bool     // 是否对整组取反，生成 NOT (...)
+}
+
+func (f *GroupFilter) GetKey() string {
+	return f.Key
+}
+
+func (f *GroupFilter) GetFields() []string {
+	fields := make([]string, 0, len(f.Children))
+	for _, child := range f.Children {
+		fields = append(fields, child.GetFields()...)
+	}
+	return fields
+}
+
+func (f *GroupFilter) GetCombinator() string {
+	if f.Combinator == "" {
+		f.Combinator = CombinatorAnd
+	}
+	return strings.ToUpper(f.Combinator)
+}
+
+func (f *GroupFilter) GetSymbol() string {
+	return f.GetCombinator()
+}
+
+func (f *GroupFilter) GetValue() interface{} {
+	return nil
+}
+
+func (f *GroupFilter) SetValue(value interface{}) {}
+
+// IsRequired 只要存在任一必选子过滤器即视为该组必选，实际的“必填但未传值”校验
+// 仍由各子过滤器自身的 Parse 负责触发
+func (f *GroupFilter) IsRequired() bool {
+	for _, child := range f.Children {
+		if child.IsRequired() {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *GroupFilter) Parse(c IQuery) error {
+	for _, child := range f.Children {
+		if err := child.Parse(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// session 返回挂在 db 同一连接/方言上的一个全新、干净的 *gorm.DB，
+// 供子过滤器各自 Bind 出独立的子语句，互不污染
+func (f *GroupFilter) session(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true})
+}
+
+func (f *GroupFilter) Bind(db *gorm.DB) *gorm.DB {
+	if len(f.Children) == 0 {
+		return db
+	}
+	var sub *gorm.DB
+	for _, child := range f.Children {
+		childDB := child.Bind(f.session(db))
+		switch {
+		case sub == nil:
+			sub = childDB
+		case f.GetCombinator() == CombinatorOr:
+			sub = sub.Or(childDB)
+		default:
+			sub = sub.Where(childDB)
+		}
+	}
+	if sub == nil {
+		return db
+	}
+	if f.Not {
+		return db.Not(sub)
+	}
+	return db.Where(sub)
+}
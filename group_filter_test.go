@@ -0,0 +1,112 @@
+package webquery
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type groupFilterUser struct {
+	ID    uint
+	Email string
+	Phone string
+	Name  string
+	Age   int
+}
+
+func openGroupFilterTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	return db
+}
+
+// TestGroupFilter_Bind_OR 验证 Or(...) 生成带括号的 OR 子句，而不是把函数指针当作
+// 主键等值条件落地（此前 db.Where(func(*gorm.DB) *gorm.DB{...}) 的写法会产出
+// "WHERE users.id = ?"，是本测试要拦住的回归）
+func TestGroupFilter_Bind_OR(t *testing.T) {
+	db := openGroupFilterTestDB(t)
+
+	email := &StringFilter{Key: "email", Symbol: "LIKE"}
+	email.SetValue("a")
+	phone := &StringFilter{Key: "phone", Symbol: "LIKE"}
+	phone.SetValue("b")
+	group := Or(email, phone)
+
+	var users []groupFilterUser
+	tx := group.Bind(db.Session(&gorm.Session{DryRun: true}).Model(&groupFilterUser{})).Find(&users)
+
+	wantSQL := "SELECT * FROM `group_filter_users` WHERE email LIKE ? OR phone LIKE ?"
+	if got := tx.Statement.SQL.String(); got != wantSQL {
+		t.Fatalf("SQL = %q, want %q", got, wantSQL)
+	}
+	wantVars := []interface{}{"%a%", "%b%"}
+	if got := tx.Statement.Vars; !varsEqual(got, wantVars) {
+		t.Fatalf("Vars = %v, want %v", got, wantVars)
+	}
+}
+
+// TestGroupFilter_Bind_CombinedWithSiblings 验证 GroupFilter 与其它顶层 Filter 以 AND
+// 拼接时，组内的 OR 表达式被正确加上括号，不会被其它 AND 条件吞掉优先级
+func TestGroupFilter_Bind_CombinedWithSiblings(t *testing.T) {
+	db := openGroupFilterTestDB(t)
+
+	email := &StringFilter{Key: "email", Symbol: "LIKE"}
+	email.SetValue("a")
+	phone := &StringFilter{Key: "phone", Symbol: "LIKE"}
+	phone.SetValue("b")
+	group := Or(email, phone)
+
+	age := &IntFilter{Key: "age", Symbol: ">"}
+	age.SetValue(18)
+
+	var users []groupFilterUser
+	tx := db.Session(&gorm.Session{DryRun: true}).Model(&groupFilterUser{}).Where("name = ?", "bob")
+	tx = group.Bind(tx)
+	tx = age.Bind(tx)
+	tx = tx.Find(&users)
+
+	wantSQL := "SELECT * FROM `group_filter_users` WHERE name = ? AND (email LIKE ? OR phone LIKE ?) AND age > ?"
+	if got := tx.Statement.SQL.String(); got != wantSQL {
+		t.Fatalf("SQL = %q, want %q", got, wantSQL)
+	}
+	wantVars := []interface{}{"bob", "%a%", "%b%", 18}
+	if got := tx.Statement.Vars; !varsEqual(got, wantVars) {
+		t.Fatalf("Vars = %v, want %v", got, wantVars)
+	}
+}
+
+// TestGroupFilter_Bind_Not 验证 Not:true 生成 NOT (...) 包裹整组
+func TestGroupFilter_Bind_Not(t *testing.T) {
+	db := openGroupFilterTestDB(t)
+
+	email := &StringFilter{Key: "email", Symbol: "LIKE"}
+	email.SetValue("a")
+	phone := &StringFilter{Key: "phone", Symbol: "LIKE"}
+	phone.SetValue("b")
+	group := Or(email, phone)
+	group.Not = true
+
+	var users []groupFilterUser
+	tx := group.Bind(db.Session(&gorm.Session{DryRun: true}).Model(&groupFilterUser{})).Find(&users)
+
+	wantSQL := "SELECT * FROM `group_filter_users` WHERE NOT (email LIKE ? OR phone LIKE ?)"
+	if got := tx.Statement.SQL.String(); got != wantSQL {
+		t.Fatalf("SQL = %q, want %q", got, wantSQL)
+	}
+}
+
+func varsEqual(got, want []interface{}) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -2,22 +2,48 @@ package webquery
 
 import (
 	"errors"
+
 	"gorm.io/gorm"
 )
 
-func NewModel(db *gorm.DB, value interface{}) *Model {
-	return &Model{
+func NewModel[T any](db *gorm.DB, value T) *Model[T] {
+	return &Model[T]{
 		DB:    db,
 		Value: value,
 	}
 }
 
-type Model struct {
-	DB    *gorm.DB
-	Value interface{}
+type Model[T any] struct {
+	DB     *gorm.DB
+	Value  T
+	schema *Schema // 延迟构建并缓存的列名白名单
+}
+
+// schemaOf 返回模型的列名白名单，首次调用时解析并缓存
+func (m *Model[T]) schemaOf() (*Schema, error) {
+	if m.schema == nil {
+		s, err := NewSchema(m.DB, m.Value)
+		if err != nil {
+			return nil, err
+		}
+		m.schema = s
+	}
+	return m.schema, nil
+}
+
+// attach 将 query 绑定到模型的列名白名单上，对其 Filters/Sorts 做一次性校验
+func (m *Model[T]) attach(query *Query) error {
+	schema, err := m.schemaOf()
+	if err != nil {
+		return err
+	}
+	return query.AttachSchema(schema)
 }
 
-func (m *Model) List(query *Query) (rets []interface{}, err error) {
+func (m *Model[T]) List(query *Query) (rets []T, err error) {
+	if err = m.attach(query); err != nil {
+		return
+	}
 	err = query.Bind(m.DB).Model(m.Value).Find(&rets).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return
@@ -25,7 +51,10 @@ func (m *Model) List(query *Query) (rets []interface{}, err error) {
 	return rets, nil
 }
 
-func (m *Model) ListAndCount(query *Query) (rets []interface{}, count int64, err error) {
+func (m *Model[T]) ListAndCount(query *Query) (rets []T, count int64, err error) {
+	if err = m.attach(query); err != nil {
+		return
+	}
 	db := query.BindFilter(m.DB).Model(m.Value)
 	if err = db.Count(&count).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return
@@ -38,3 +67,50 @@ func (m *Model) ListAndCount(query *Query) (rets []interface{}, count int64, err
 	}
 	return rets, count, nil
 }
+
+// GetByPK 按主键查询单条记录
+func (m *Model[T]) GetByPK(pk any) (ret T, err error) {
+	err = m.DB.Model(m.Value).First(&ret, pk).Error
+	return
+}
+
+// First 按 Query 指定的过滤、排序条件查询首条记录
+func (m *Model[T]) First(query *Query) (ret T, err error) {
+	if err = m.attach(query); err != nil {
+		return
+	}
+	db := query.BindSort(query.BindFilter(m.DB).Model(m.Value))
+	err = db.First(&ret).Error
+	return
+}
+
+// Create 新增一条记录
+func (m *Model[T]) Create(v *T) error {
+	return m.DB.Model(m.Value).Create(v).Error
+}
+
+// CreateMany 批量新增
+func (m *Model[T]) CreateMany(vs []T) error {
+	if len(vs) == 0 {
+		return nil
+	}
+	return m.DB.Model(m.Value).Create(&vs).Error
+}
+
+// UpdateByFilter 按 Query 的过滤条件批量更新，返回受影响的行数
+func (m *Model[T]) UpdateByFilter(query *Query, updates map[string]any) (int64, error) {
+	if err := m.attach(query); err != nil {
+		return 0, err
+	}
+	db := query.BindFilter(m.DB).Model(m.Value).Updates(updates)
+	return db.RowsAffected, db.Error
+}
+
+// DeleteByFilter 按 Query 的过滤条件批量删除，返回受影响的行数
+func (m *Model[T]) DeleteByFilter(query *Query) (int64, error) {
+	if err := m.attach(query); err != nil {
+		return 0, err
+	}
+	db := query.BindFilter(m.DB).Model(m.Value).Delete(new(T))
+	return db.RowsAffected, db.Error
+}
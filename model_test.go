@@ -0,0 +1,189 @@
+package webquery
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type modelTestUser struct {
+	ID    uint
+	Name  string
+	Email string
+	Age   int
+}
+
+func openModelTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&modelTestUser{}); err != nil {
+		t.Fatalf("AutoMigrate: %v", err)
+	}
+	return db
+}
+
+func newModelTestModel(t *testing.T) (*gorm.DB, *Model[modelTestUser]) {
+	db := openModelTestDB(t)
+	return db, NewModel(db, modelTestUser{})
+}
+
+func TestModel_CreateAndGetByPK(t *testing.T) {
+	_, m := newModelTestModel(t)
+
+	u := &modelTestUser{Name: "bob", Email: "bob@example.com", Age: 20}
+	if err := m.Create(u); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if u.ID == 0 {
+		t.Fatalf("expected ID to be populated after Create")
+	}
+
+	got, err := m.GetByPK(u.ID)
+	if err != nil {
+		t.Fatalf("GetByPK: %v", err)
+	}
+	if got.Name != "bob" {
+		t.Fatalf("Name = %q, want bob", got.Name)
+	}
+}
+
+func TestModel_CreateMany(t *testing.T) {
+	_, m := newModelTestModel(t)
+
+	if err := m.CreateMany(nil); err != nil {
+		t.Fatalf("CreateMany with empty slice should be a no-op, got: %v", err)
+	}
+
+	users := []modelTestUser{
+		{Name: "a", Email: "a@example.com", Age: 10},
+		{Name: "b", Email: "b@example.com", Age: 20},
+	}
+	if err := m.CreateMany(users); err != nil {
+		t.Fatalf("CreateMany: %v", err)
+	}
+
+	var count int64
+	m.DB.Model(&modelTestUser{}).Count(&count)
+	if count != 2 {
+		t.Fatalf("count = %d, want 2", count)
+	}
+}
+
+func TestModel_ListAndFirst(t *testing.T) {
+	_, m := newModelTestModel(t)
+	if err := m.CreateMany([]modelTestUser{
+		{Name: "a", Email: "a@example.com", Age: 10},
+		{Name: "b", Email: "b@example.com", Age: 20},
+	}); err != nil {
+		t.Fatalf("CreateMany: %v", err)
+	}
+
+	age := &IntFilter{Key: "age", Symbol: ">"}
+	age.SetValue(15)
+	q := &Query{Filters: []Filter{age}, Page: 1, PageSize: 10}
+
+	rets, err := m.List(q)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(rets) != 1 || rets[0].Name != "b" {
+		t.Fatalf("List result = %+v, want only user b", rets)
+	}
+
+	q2 := &Query{Filters: []Filter{&IntFilter{Key: "age", Symbol: ">"}}}
+	q2.Filters[0].SetValue(0)
+	first, err := m.First(q2)
+	if err != nil {
+		t.Fatalf("First: %v", err)
+	}
+	if first.Name == "" {
+		t.Fatalf("expected a record from First")
+	}
+}
+
+// TestModel_UpdateByFilter 验证 UpdateByFilter 真正依据 Query 的过滤条件生效，
+// 并在变更前通过 attach 触发 schema 校验
+func TestModel_UpdateByFilter(t *testing.T) {
+	_, m := newModelTestModel(t)
+	if err := m.CreateMany([]modelTestUser{
+		{Name: "a", Email: "a@example.com", Age: 10},
+		{Name: "b", Email: "b@example.com", Age: 20},
+	}); err != nil {
+		t.Fatalf("CreateMany: %v", err)
+	}
+
+	name := &StringFilter{Key: "name"}
+	name.SetValue("a")
+	q := &Query{Filters: []Filter{name}}
+
+	affected, err := m.UpdateByFilter(q, map[string]any{"age": 99})
+	if err != nil {
+		t.Fatalf("UpdateByFilter: %v", err)
+	}
+	if affected != 1 {
+		t.Fatalf("affected = %d, want 1", affected)
+	}
+
+	got, err := m.GetByPK(1)
+	if err != nil {
+		t.Fatalf("GetByPK: %v", err)
+	}
+	if got.Age != 99 {
+		t.Fatalf("age = %d, want 99 (update should only touch the matching row)", got.Age)
+	}
+
+	unaffected, err := m.GetByPK(2)
+	if err != nil {
+		t.Fatalf("GetByPK: %v", err)
+	}
+	if unaffected.Age != 20 {
+		t.Fatalf("age = %d, want unchanged 20", unaffected.Age)
+	}
+}
+
+// TestModel_UpdateByFilter_SchemaValidation 验证未知列名会在 attach 阶段被拒绝，
+// 而不是被悄悄传给数据库
+func TestModel_UpdateByFilter_SchemaValidation(t *testing.T) {
+	_, m := newModelTestModel(t)
+
+	bogus := &StringFilter{Key: "bogus", Field: "does_not_exist"}
+	bogus.SetValue("x")
+	q := &Query{Filters: []Filter{bogus}}
+
+	if _, err := m.UpdateByFilter(q, map[string]any{"age": 1}); err == nil {
+		t.Fatalf("expected schema validation error for unknown column")
+	}
+}
+
+// TestModel_DeleteByFilter 验证 DeleteByFilter 按 Query 的过滤条件删除对应记录
+func TestModel_DeleteByFilter(t *testing.T) {
+	_, m := newModelTestModel(t)
+	if err := m.CreateMany([]modelTestUser{
+		{Name: "a", Email: "a@example.com", Age: 10},
+		{Name: "b", Email: "b@example.com", Age: 20},
+	}); err != nil {
+		t.Fatalf("CreateMany: %v", err)
+	}
+
+	age := &IntFilter{Key: "age", Symbol: "<"}
+	age.SetValue(15)
+	q := &Query{Filters: []Filter{age}}
+
+	affected, err := m.DeleteByFilter(q)
+	if err != nil {
+		t.Fatalf("DeleteByFilter: %v", err)
+	}
+	if affected != 1 {
+		t.Fatalf("affected = %d, want 1", affected)
+	}
+
+	var count int64
+	m.DB.Model(&modelTestUser{}).Count(&count)
+	if count != 1 {
+		t.Fatalf("remaining count = %d, want 1", count)
+	}
+}
@@ -1,7 +1,9 @@
 package webquery
 
 import (
+	"fmt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"strings"
 )
@@ -44,8 +46,40 @@ type Query struct {
 	Page     int
 	PageSize int
 	Sorts    []Sort
-	sort     string
-	Config   *Config
+	Locking  bool // 是否追加 SELECT ... FOR UPDATE 行锁，需在事务内使用
+	sort       string
+	schema     *Schema  // 绑定模型后的列名白名单，由 AttachSchema 注入
+	afterParse []func() // 解析成功后执行的回调，由 NewQueryFromStruct 注册，用于把解析值写回原结构体字段
+	Config     *Config
+}
+
+// ForUpdate 开启行锁，令 BindFilter 追加 SELECT ... FOR UPDATE
+func (q *Query) ForUpdate() *Query {
+	q.Locking = true
+	return q
+}
+
+// AttachSchema 将 Query 绑定到某个模型的列名白名单上：
+// 立即校验已注册的 Filters/Sorts 是否引用了非法列名或运算符（fail fast），
+// 并使后续 Parse 在请求阶段拒绝 sort 参数中的未知标识符。
+func (q *Query) AttachSchema(s *Schema) error {
+	if s == nil {
+		return nil
+	}
+	if err := s.Validate(q.Filters); err != nil {
+		return err
+	}
+	for _, sort := range q.Sorts {
+		field := sort.Field
+		if field == "" {
+			field = sort.Key
+		}
+		if !s.HasColumn(field) {
+			return fmt.Errorf("query: unknown sort column [%s]", field)
+		}
+	}
+	q.schema = s
+	return nil
 }
 
 func (q *Query) Parse(c IQuery) error {
@@ -54,8 +88,16 @@ func (q *Query) Parse(c IQuery) error {
 	}
 	q.Config = q.Config.Default()
 	q.parsePage(c)
-	q.parseSort(c)
-	return q.parseFilter(c)
+	if err := q.parseSort(c); err != nil {
+		return err
+	}
+	if err := q.parseFilter(c); err != nil {
+		return err
+	}
+	for _, sync := range q.afterParse {
+		sync()
+	}
+	return nil
 }
 
 func (q *Query) parsePage(c IQuery) {
@@ -72,8 +114,28 @@ func (q *Query) parsePage(c IQuery) {
 	}
 }
 
-func (q *Query) parseSort(c IQuery) {
+func (q *Query) parseSort(c IQuery) error {
 	q.sort = strings.TrimSpace(c.Query(q.Config.SortParam))
+	if q.sort == "" || q.schema == nil {
+		return nil
+	}
+	for _, sort := range strings.Split(q.sort, ",") {
+		sortKey := strings.TrimPrefix(strings.TrimSpace(sort), "-")
+		if sortKey == "" {
+			continue
+		}
+		known := false
+		for _, s := range q.Sorts {
+			if s.Key == sortKey {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("query: unknown sort key [%s]", sortKey)
+		}
+	}
+	return nil
 }
 
 func (q *Query) parseFilter(c IQuery) error {
@@ -111,9 +173,8 @@ func (q *Query) BindSort(db *gorm.DB) *gorm.DB {
 		sortMode := "ASC"
 		if strings.HasPrefix(sortKey, "-") {
 			sortMode = "DESC"
+			sortKey = strings.TrimPrefix(sortKey, "-")
 		}
-		// sql 防注入
-		sortKey = sqlAntiInject(sortKey)
 
 		for _, s := range q.Sorts {
 			if s.Key != sortKey {
@@ -133,6 +194,9 @@ func (q *Query) BindFilter(db *gorm.DB) *gorm.DB {
 	for _, filter := range q.Filters {
 		db = filter.Bind(db)
 	}
+	if q.Locking {
+		db = db.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
 	return db
 }
 
@@ -0,0 +1,555 @@
+package webquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 区间过滤器的取值模式
+const (
+	RangeModeSeparate = "separate" // 起止值由两个独立参数传入，如 created_at_start/created_at_end
+	RangeModeCombined = "combined" // 起止值由单一参数传入，如 created_at=2024-01-01~2024-02-01
+)
+
+// buildRangeClause 根据起止值是否存在，拼接 BETWEEN / 单边比较表达式
+func buildRangeClause(field string, hasStart bool, startVal interface{}, hasEnd bool, endVal interface{}, inclusive bool) (string, []interface{}) {
+	switch {
+	case hasStart && hasEnd:
+		if inclusive {
+			return field + " BETWEEN ? AND ?", []interface{}{startVal, endVal}
+		}
+		return field + " > ? AND " + field + " < ?", []interface{}{startVal, endVal}
+	case hasStart:
+		if inclusive {
+			return field + " >= ?", []interface{}{startVal}
+		}
+		return field + " > ?", []interface{}{startVal}
+	case hasEnd:
+		if inclusive {
+			return field + " <= ?", []interface{}{endVal}
+		}
+		return field + " < ?", []interface{}{endVal}
+	default:
+		return "", nil
+	}
+}
+
+// splitRangeValue 拆分 combined 模式下的单一参数，允许只给起始值或只给结束值
+func splitRangeValue(value, sep string) (start, end string) {
+	parts := strings.SplitN(value, sep, 2)
+	start = strings.TrimSpace(parts[0])
+	if len(parts) == 2 {
+		end = strings.TrimSpace(parts[1])
+	}
+	return
+}
+
+// IntRangeFilter 区间查询，生成 BETWEEN ? AND ? 或单边 >=/<= 条件，适用于整数字段
+type IntRangeFilter struct {
+	Key        string    // 前端传入参数名，Mode 为 combined 时使用，值形如 10~20
+	StartKey   string    // 起始值参数名，Mode 为 separate 时使用，默认 Key+"_start"
+	EndKey     string    // 结束值参数名，Mode 为 separate 时使用，默认 Key+"_end"
+	Field      string    // 数据库单列
+	Fields     []string  // 数据库多列
+	Mode       string    // RangeModeSeparate（默认）或 RangeModeCombined
+	Sep        string    // combined 模式下的分隔符，默认 "~"
+	Exclusive  bool      // true 时使用 > / < 严格区间，false（默认）使用 BETWEEN 闭区间
+	Start      *int      // 用户指定的起始值，优先于解析值
+	End        *int      // 用户指定的结束值，优先于解析值
+	ParseStart *int      // 解析得到的起始值
+	ParseEnd   *int      // 解析得到的结束值
+	parsed     bool      // 是否解析过
+	Required   bool      // 是否必选（起止值需至少存在一个）
+	ParseFunc  ParseFunc // 自定义转换函数
+	BindFunc   BindFunc  // 自定义绑定到查询条件
+}
+
+func (f *IntRangeFilter) GetKey() string {
+	return f.Key
+}
+
+func (f *IntRangeFilter) GetFields() []string {
+	if len(f.Fields) > 0 {
+		return f.Fields
+	}
+	if f.Field == "" {
+		f.Field = f.Key
+	}
+	f.Fields = []string{f.Field}
+	return f.Fields
+}
+
+func (f *IntRangeFilter) GetMode() string {
+	if f.Mode == "" {
+		f.Mode = RangeModeSeparate
+	}
+	return f.Mode
+}
+
+func (f *IntRangeFilter) GetSep() string {
+	if f.Sep == "" {
+		f.Sep = "~"
+	}
+	return f.Sep
+}
+
+func (f *IntRangeFilter) GetStartKey() string {
+	if f.StartKey == "" {
+		f.StartKey = f.Key + "_start"
+	}
+	return f.StartKey
+}
+
+func (f *IntRangeFilter) GetEndKey() string {
+	if f.EndKey == "" {
+		f.EndKey = f.Key + "_end"
+	}
+	return f.EndKey
+}
+
+func (f *IntRangeFilter) GetSymbol() string {
+	return "BETWEEN"
+}
+
+func (f *IntRangeFilter) IsRequired() bool {
+	return f.Required
+}
+
+func (f *IntRangeFilter) getStart() (bool, int) {
+	if f.Start != nil {
+		return true, *f.Start
+	}
+	if f.ParseStart != nil {
+		return true, *f.ParseStart
+	}
+	return false, 0
+}
+
+func (f *IntRangeFilter) getEnd() (bool, int) {
+	if f.End != nil {
+		return true, *f.End
+	}
+	if f.ParseEnd != nil {
+		return true, *f.ParseEnd
+	}
+	return false, 0
+}
+
+func (f *IntRangeFilter) GetValue() interface{} {
+	_, start := f.getStart()
+	_, end := f.getEnd()
+	return [2]int{start, end}
+}
+
+func (f *IntRangeFilter) SetValue(value interface{}) {
+	f.parsed = true
+}
+
+func (f *IntRangeFilter) Parse(c IQuery) error {
+	if f.ParseFunc != nil {
+		return f.ParseFunc(f, c)
+	}
+	var startStr, endStr string
+	if f.GetMode() == RangeModeCombined {
+		value := strings.TrimSpace(c.Query(f.GetKey()))
+		if value == "" {
+			if f.IsRequired() {
+				return fmt.Errorf("filter key [%s] is required", f.GetKey())
+			}
+			return nil
+		}
+		startStr, endStr = splitRangeValue(value, f.GetSep())
+	} else {
+		startStr = strings.TrimSpace(c.Query(f.GetStartKey()))
+		endStr = strings.TrimSpace(c.Query(f.GetEndKey()))
+		if startStr == "" && endStr == "" {
+			if f.IsRequired() {
+				return fmt.Errorf("filter key [%s/%s] is required", f.GetStartKey(), f.GetEndKey())
+			}
+			return nil
+		}
+	}
+	if startStr != "" {
+		start, err := strconv.Atoi(startStr)
+		if err != nil {
+			return err
+		}
+		f.ParseStart = &start
+	}
+	if endStr != "" {
+		end, err := strconv.Atoi(endStr)
+		if err != nil {
+			return err
+		}
+		f.ParseEnd = &end
+	}
+	f.parsed = true
+	return nil
+}
+
+func (f *IntRangeFilter) Bind(db *gorm.DB) *gorm.DB {
+	if !f.parsed {
+		return db
+	}
+	if f.BindFunc != nil {
+		return f.BindFunc(f, db)
+	}
+	hasStart, start := f.getStart()
+	hasEnd, end := f.getEnd()
+	if !hasStart && !hasEnd {
+		return db
+	}
+	fields := f.GetFields()
+	clauses := make([]string, 0, len(fields))
+	values := make([]interface{}, 0, len(fields)*2)
+	for _, field := range fields {
+		clause, vals := buildRangeClause(field, hasStart, start, hasEnd, end, !f.Exclusive)
+		clauses = append(clauses, clause)
+		values = append(values, vals...)
+	}
+	return db.Where(strings.Join(clauses, " OR "), values...)
+}
+
+// FloatRangeFilter 区间查询，生成 BETWEEN ? AND ? 或单边 >=/<= 条件，适用于浮点数字段
+type FloatRangeFilter struct {
+	Key        string    // 前端传入参数名，Mode 为 combined 时使用，值形如 1.5~2.5
+	StartKey   string    // 起始值参数名，Mode 为 separate 时使用，默认 Key+"_start"
+	EndKey     string    // 结束值参数名，Mode 为 separate 时使用，默认 Key+"_end"
+	Field      string    // 数据库单列
+	Fields     []string  // 数据库多列
+	Mode       string    // RangeModeSeparate（默认）或 RangeModeCombined
+	Sep        string    // combined 模式下的分隔符，默认 "~"
+	Exclusive  bool      // true 时使用 > / < 严格区间，false（默认）使用 BETWEEN 闭区间
+	Start      *float64  // 用户指定的起始值，优先于解析值
+	End        *float64  // 用户指定的结束值，优先于解析值
+	ParseStart *float64  // 解析得到的起始值
+	ParseEnd   *float64  // 解析得到的结束值
+	parsed     bool      // 是否解析过
+	Required   bool      // 是否必选（起止值需至少存在一个）
+	ParseFunc  ParseFunc // 自定义转换函数
+	BindFunc   BindFunc  // 自定义绑定到查询条件
+}
+
+func (f *FloatRangeFilter) GetKey() string {
+	return f.Key
+}
+
+func (f *FloatRangeFilter) GetFields() []string {
+	if len(f.Fields) > 0 {
+		return f.Fields
+	}
+	if f.Field == "" {
+		f.Field = f.Key
+	}
+	f.Fields = []string{f.Field}
+	return f.Fields
+}
+
+func (f *FloatRangeFilter) GetMode() string {
+	if f.Mode == "" {
+		f.Mode = RangeModeSeparate
+	}
+	return f.Mode
+}
+
+func (f *FloatRangeFilter) GetSep() string {
+	if f.Sep == "" {
+		f.Sep = "~"
+	}
+	return f.Sep
+}
+
+func (f *FloatRangeFilter) GetStartKey() string {
+	if f.StartKey == "" {
+		f.StartKey = f.Key + "_start"
+	}
+	return f.StartKey
+}
+
+func (f *FloatRangeFilter) GetEndKey() string {
+	if f.EndKey == "" {
+		f.EndKey = f.Key + "_end"
+	}
+	return f.EndKey
+}
+
+func (f *FloatRangeFilter) GetSymbol() string {
+	return "BETWEEN"
+}
+
+func (f *FloatRangeFilter) IsRequired() bool {
+	return f.Required
+}
+
+func (f *FloatRangeFilter) getStart() (bool, float64) {
+	if f.Start != nil {
+		return true, *f.Start
+	}
+	if f.ParseStart != nil {
+		return true, *f.ParseStart
+	}
+	return false, 0
+}
+
+func (f *FloatRangeFilter) getEnd() (bool, float64) {
+	if f.End != nil {
+		return true, *f.End
+	}
+	if f.ParseEnd != nil {
+		return true, *f.ParseEnd
+	}
+	return false, 0
+}
+
+func (f *FloatRangeFilter) GetValue() interface{} {
+	_, start := f.getStart()
+	_, end := f.getEnd()
+	return [2]float64{start, end}
+}
+
+func (f *FloatRangeFilter) SetValue(value interface{}) {
+	f.parsed = true
+}
+
+func (f *FloatRangeFilter) Parse(c IQuery) error {
+	if f.ParseFunc != nil {
+		return f.ParseFunc(f, c)
+	}
+	var startStr, endStr string
+	if f.GetMode() == RangeModeCombined {
+		value := strings.TrimSpace(c.Query(f.GetKey()))
+		if value == "" {
+			if f.IsRequired() {
+				return fmt.Errorf("filter key [%s] is required", f.GetKey())
+			}
+			return nil
+		}
+		startStr, endStr = splitRangeValue(value, f.GetSep())
+	} else {
+		startStr = strings.TrimSpace(c.Query(f.GetStartKey()))
+		endStr = strings.TrimSpace(c.Query(f.GetEndKey()))
+		if startStr == "" && endStr == "" {
+			if f.IsRequired() {
+				return fmt.Errorf("filter key [%s/%s] is required", f.GetStartKey(), f.GetEndKey())
+			}
+			return nil
+		}
+	}
+	if startStr != "" {
+		start, err := strconv.ParseFloat(startStr, 64)
+		if err != nil {
+			return err
+		}
+		f.ParseStart = &start
+	}
+	if endStr != "" {
+		end, err := strconv.ParseFloat(endStr, 64)
+		if err != nil {
+			return err
+		}
+		f.ParseEnd = &end
+	}
+	f.parsed = true
+	return nil
+}
+
+func (f *FloatRangeFilter) Bind(db *gorm.DB) *gorm.DB {
+	if !f.parsed {
+		return db
+	}
+	if f.BindFunc != nil {
+		return f.BindFunc(f, db)
+	}
+	hasStart, start := f.getStart()
+	hasEnd, end := f.getEnd()
+	if !hasStart && !hasEnd {
+		return db
+	}
+	fields := f.GetFields()
+	clauses := make([]string, 0, len(fields))
+	values := make([]interface{}, 0, len(fields)*2)
+	for _, field := range fields {
+		clause, vals := buildRangeClause(field, hasStart, start, hasEnd, end, !f.Exclusive)
+		clauses = append(clauses, clause)
+		values = append(values, vals...)
+	}
+	return db.Where(strings.Join(clauses, " OR "), values...)
+}
+
+// TimeRangeFilter 区间查询，生成 BETWEEN ? AND ? 或单边 >=/<= 条件，适用于时间字段
+type TimeRangeFilter struct {
+	Key        string     // 前端传入参数名，Mode 为 combined 时使用，值形如 2024-01-01~2024-02-01
+	StartKey   string     // 起始值参数名，Mode 为 separate 时使用，默认 Key+"_start"
+	EndKey     string     // 结束值参数名，Mode 为 separate 时使用，默认 Key+"_end"
+	Field      string     // 数据库单列
+	Fields     []string   // 数据库多列
+	Mode       string     // RangeModeSeparate（默认）或 RangeModeCombined
+	Sep        string     // combined 模式下的分隔符，默认 "~"
+	Layout     string     // 时间格式，默认 "2006-01-02 15:04:05"
+	Exclusive  bool       // true 时使用 > / < 严格区间，false（默认）使用 BETWEEN 闭区间
+	Start      *time.Time // 用户指定的起始值，优先于解析值
+	End        *time.Time // 用户指定的结束值，优先于解析值
+	ParseStart *time.Time // 解析得到的起始值
+	ParseEnd   *time.Time // 解析得到的结束值
+	parsed     bool       // 是否解析过
+	Required   bool       // 是否必选（起止值需至少存在一个）
+	ParseFunc  ParseFunc  // 自定义转换函数
+	BindFunc   BindFunc   // 自定义绑定到查询条件
+}
+
+func (f *TimeRangeFilter) GetKey() string {
+	return f.Key
+}
+
+func (f *TimeRangeFilter) GetFields() []string {
+	if len(f.Fields) > 0 {
+		return f.Fields
+	}
+	if f.Field == "" {
+		f.Field = f.Key
+	}
+	f.Fields = []string{f.Field}
+	return f.Fields
+}
+
+func (f *TimeRangeFilter) GetMode() string {
+	if f.Mode == "" {
+		f.Mode = RangeModeSeparate
+	}
+	return f.Mode
+}
+
+func (f *TimeRangeFilter) GetSep() string {
+	if f.Sep == "" {
+		f.Sep = "~"
+	}
+	return f.Sep
+}
+
+func (f *TimeRangeFilter) GetLayout() string {
+	if f.Layout == "" {
+		f.Layout = "2006-01-02 15:04:05"
+	}
+	return f.Layout
+}
+
+func (f *TimeRangeFilter) GetStartKey() string {
+	if f.StartKey == "" {
+		f.StartKey = f.Key + "_start"
+	}
+	return f.StartKey
+}
+
+func (f *TimeRangeFilter) GetEndKey() string {
+	if f.EndKey == "" {
+		f.EndKey = f.Key + "_end"
+	}
+	return f.EndKey
+}
+
+func (f *TimeRangeFilter) GetSymbol() string {
+	return "BETWEEN"
+}
+
+func (f *TimeRangeFilter) IsRequired() bool {
+	return f.Required
+}
+
+func (f *TimeRangeFilter) getStart() (bool, time.Time) {
+	if f.Start != nil {
+		return true, *f.Start
+	}
+	if f.ParseStart != nil {
+		return true, *f.ParseStart
+	}
+	return false, time.Time{}
+}
+
+func (f *TimeRangeFilter) getEnd() (bool, time.Time) {
+	if f.End != nil {
+		return true, *f.End
+	}
+	if f.ParseEnd != nil {
+		return true, *f.ParseEnd
+	}
+	return false, time.Time{}
+}
+
+func (f *TimeRangeFilter) GetValue() interface{} {
+	_, start := f.getStart()
+	_, end := f.getEnd()
+	return [2]time.Time{start, end}
+}
+
+func (f *TimeRangeFilter) SetValue(value interface{}) {
+	f.parsed = true
+}
+
+func (f *TimeRangeFilter) Parse(c IQuery) error {
+	if f.ParseFunc != nil {
+		return f.ParseFunc(f, c)
+	}
+	var startStr, endStr string
+	if f.GetMode() == RangeModeCombined {
+		value := strings.TrimSpace(c.Query(f.GetKey()))
+		if value == "" {
+			if f.IsRequired() {
+				return fmt.Errorf("filter key [%s] is required", f.GetKey())
+			}
+			return nil
+		}
+		startStr, endStr = splitRangeValue(value, f.GetSep())
+	} else {
+		startStr = strings.TrimSpace(c.Query(f.GetStartKey()))
+		endStr = strings.TrimSpace(c.Query(f.GetEndKey()))
+		if startStr == "" && endStr == "" {
+			if f.IsRequired() {
+				return fmt.Errorf("filter key [%s/%s] is required", f.GetStartKey(), f.GetEndKey())
+			}
+			return nil
+		}
+	}
+	if startStr != "" {
+		start, err := time.ParseInLocation(f.GetLayout(), startStr, time.Local)
+		if err != nil {
+			return err
+		}
+		f.ParseStart = &start
+	}
+	if endStr != "" {
+		end, err := time.ParseInLocation(f.GetLayout(), endStr, time.Local)
+		if err != nil {
+			return err
+		}
+		f.ParseEnd = &end
+	}
+	f.parsed = true
+	return nil
+}
+
+func (f *TimeRangeFilter) Bind(db *gorm.DB) *gorm.DB {
+	if !f.parsed {
+		return db
+	}
+	if f.BindFunc != nil {
+		return f.BindFunc(f, db)
+	}
+	hasStart, start := f.getStart()
+	hasEnd, end := f.getEnd()
+	if !hasStart && !hasEnd {
+		return db
+	}
+	fields := f.GetFields()
+	clauses := make([]string, 0, len(fields))
+	values := make([]interface{}, 0, len(fields)*2)
+	for _, field := range fields {
+		clause, vals := buildRangeClause(field, hasStart, start, hasEnd, end, !f.Exclusive)
+		clauses = append(clauses, clause)
+		values = append(values, vals...)
+	}
+	return db.Where(strings.Join(clauses, " OR "), values...)
+}
@@ -0,0 +1,154 @@
+package webquery
+
+import (
+	"testing"
+	"time"
+)
+
+// mapQuery 是一个最小化的 IQuery 实现，用于在不依赖 HTTP 框架的情况下驱动
+// Filter.Parse 的单元测试
+type mapQuery map[string]string
+
+func (m mapQuery) Query(key string) string {
+	return m[key]
+}
+
+// TestIntRangeFilter_OpenEnded 验证只传起始值或只传结束值时，生成单边 >=/<= 条件，
+// 而不是要求两端都存在才生效
+func TestIntRangeFilter_OpenEnded(t *testing.T) {
+	t.Run("start only", func(t *testing.T) {
+		f := &IntRangeFilter{Key: "age"}
+		if err := f.Parse(mapQuery{"age_start": "18"}); err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		hasStart, start := f.getStart()
+		hasEnd, _ := f.getEnd()
+		if hasEnd {
+			t.Fatalf("expected no end bound")
+		}
+		clause, args := buildRangeClause("age", hasStart, start, hasEnd, 0, !f.Exclusive)
+		if clause != "age >= ?" || len(args) != 1 || args[0] != 18 {
+			t.Fatalf("clause = %q args = %v", clause, args)
+		}
+	})
+
+	t.Run("end only", func(t *testing.T) {
+		f := &IntRangeFilter{Key: "age"}
+		if err := f.Parse(mapQuery{"age_end": "60"}); err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		hasStart, _ := f.getStart()
+		hasEnd, end := f.getEnd()
+		if hasStart {
+			t.Fatalf("expected no start bound")
+		}
+		clause, args := buildRangeClause("age", hasStart, 0, hasEnd, end, !f.Exclusive)
+		if clause != "age <= ?" || len(args) != 1 || args[0] != 60 {
+			t.Fatalf("clause = %q args = %v", clause, args)
+		}
+	})
+
+	t.Run("neither bound, not required", func(t *testing.T) {
+		f := &IntRangeFilter{Key: "age"}
+		if err := f.Parse(mapQuery{}); err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		hasStart, _ := f.getStart()
+		hasEnd, _ := f.getEnd()
+		if hasStart || hasEnd {
+			t.Fatalf("expected no bounds parsed")
+		}
+	})
+}
+
+// TestIntRangeFilter_Exclusive 验证默认（false）使用 BETWEEN 闭区间，
+// Exclusive:true 时改用严格 >/< 区间
+func TestIntRangeFilter_Exclusive(t *testing.T) {
+	f := &IntRangeFilter{Key: "age"}
+	if err := f.Parse(mapQuery{"age_start": "18", "age_end": "60"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if clause, _ := buildRangeClause("age", true, 18, true, 60, !f.Exclusive); clause != "age BETWEEN ? AND ?" {
+		t.Fatalf("default clause = %q, want BETWEEN", clause)
+	}
+
+	f.Exclusive = true
+	if clause, _ := buildRangeClause("age", true, 18, true, 60, !f.Exclusive); clause != "age > ? AND age < ?" {
+		t.Fatalf("exclusive clause = %q, want strict range", clause)
+	}
+}
+
+// TestIntRangeFilter_Required 验证 Required 且两端都缺失时返回错误
+func TestIntRangeFilter_Required(t *testing.T) {
+	f := &IntRangeFilter{Key: "age", Required: true}
+	if err := f.Parse(mapQuery{}); err == nil {
+		t.Fatalf("expected error when required range has no bounds")
+	}
+
+	f2 := &IntRangeFilter{Key: "age", Required: true}
+	if err := f2.Parse(mapQuery{"age_start": "18"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+}
+
+// TestTimeRangeFilter_Layout 验证自定义 Layout 能正确解析带时区偏移的时间，
+// 且解析结果保留了原始时刻（而非被悄悄转换成本地时间的另一时刻）
+func TestTimeRangeFilter_Layout(t *testing.T) {
+	f := &TimeRangeFilter{Key: "created_at", Layout: time.RFC3339}
+	if err := f.Parse(mapQuery{
+		"created_at_start": "2024-01-01T00:00:00+08:00",
+		"created_at_end":   "2024-02-01T00:00:00+08:00",
+	}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	hasStart, start := f.getStart()
+	hasEnd, end := f.getEnd()
+	if !hasStart || !hasEnd {
+		t.Fatalf("expected both bounds parsed")
+	}
+	wantStart, _ := time.Parse(time.RFC3339, "2024-01-01T00:00:00+08:00")
+	wantEnd, _ := time.Parse(time.RFC3339, "2024-02-01T00:00:00+08:00")
+	if !start.Equal(wantStart) {
+		t.Fatalf("start = %v, want %v", start, wantStart)
+	}
+	if !end.Equal(wantEnd) {
+		t.Fatalf("end = %v, want %v", end, wantEnd)
+	}
+}
+
+// TestTimeRangeFilter_DefaultLayout 验证未指定 Layout 时退回默认格式
+func TestTimeRangeFilter_DefaultLayout(t *testing.T) {
+	f := &TimeRangeFilter{Key: "created_at"}
+	if err := f.Parse(mapQuery{"created_at_start": "2024-01-01 00:00:00"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	hasStart, _ := f.getStart()
+	if !hasStart {
+		t.Fatalf("expected start bound parsed with default layout")
+	}
+}
+
+// TestTimeRangeFilter_CombinedMode 验证 combined 模式下单一参数以 Sep 拆分起止值，
+// 且允许只给一端
+func TestTimeRangeFilter_CombinedMode(t *testing.T) {
+	f := &TimeRangeFilter{Key: "created_at", Mode: RangeModeCombined}
+	if err := f.Parse(mapQuery{"created_at": "2024-01-01 00:00:00~2024-02-01 00:00:00"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	hasStart, _ := f.getStart()
+	hasEnd, _ := f.getEnd()
+	if !hasStart || !hasEnd {
+		t.Fatalf("expected both bounds parsed from combined value")
+	}
+
+	f2 := &TimeRangeFilter{Key: "created_at", Mode: RangeModeCombined}
+	if err := f2.Parse(mapQuery{"created_at": "2024-01-01 00:00:00~"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	hasStart2, _ := f2.getStart()
+	hasEnd2, _ := f2.getEnd()
+	if !hasStart2 || hasEnd2 {
+		t.Fatalf("expected only start bound when end half is empty")
+	}
+}
@@ -0,0 +1,89 @@
+package webquery
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// operatorOracle 合法的比较运算符白名单，防止 Symbol 夹带任意 SQL
+var operatorOracle = map[string]struct{}{
+	"=":           {},
+	">":           {},
+	"<":           {},
+	"!=":          {},
+	"<>":          {},
+	">=":          {},
+	"<=":          {},
+	"LIKE":        {},
+	"LIKER":       {}, // StringFilter 的前缀 LIKE 变体（见 filter.go GetValue）
+	"IN":          {},
+	"NOT IN":      {},
+	"BETWEEN":     {},
+	"IS NULL":     {},
+	"IS NOT NULL": {},
+}
+
+// IsValidOperator 校验 symbol 是否在合法的运算符白名单内
+func IsValidOperator(symbol string) bool {
+	_, ok := operatorOracle[strings.ToUpper(strings.TrimSpace(symbol))]
+	return ok
+}
+
+// Schema 记录某个 gorm 模型的合法列名，用于在注册/请求阶段拦截非法标识符
+type Schema struct {
+	columns map[string]struct{}
+}
+
+// NewSchema 依据 db 绑定的模型解析出列名白名单
+func NewSchema(db *gorm.DB, model interface{}) (*Schema, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return nil, fmt.Errorf("query: parse schema: %w", err)
+	}
+	columns := make(map[string]struct{}, len(stmt.Schema.Fields))
+	for _, field := range stmt.Schema.Fields {
+		if field.DBName != "" {
+			columns[field.DBName] = struct{}{}
+		}
+	}
+	return &Schema{columns: columns}, nil
+}
+
+// HasColumn 判断列名（可带 "table." 前缀）是否在白名单内
+func (s *Schema) HasColumn(name string) bool {
+	if s == nil {
+		return true
+	}
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	_, ok := s.columns[strings.ToLower(strings.TrimSpace(name))]
+	return ok
+}
+
+// Validate 校验一组 Filter 的 Field/Fields/Symbol 是否合法，供注册阶段 fail fast 使用。
+// GroupFilter 本身不是一个可比较的列表达式，递归校验其 Children 即可。
+func (s *Schema) Validate(filters []Filter) error {
+	if s == nil {
+		return nil
+	}
+	for _, f := range filters {
+		if group, ok := f.(*GroupFilter); ok {
+			if err := s.Validate(group.Children); err != nil {
+				return err
+			}
+			continue
+		}
+		if !IsValidOperator(f.GetSymbol()) {
+			return fmt.Errorf("query: illegal operator [%s] for filter key [%s]", f.GetSymbol(), f.GetKey())
+		}
+		for _, field := range f.GetFields() {
+			if !s.HasColumn(field) {
+				return fmt.Errorf("query: unknown column [%s] for filter key [%s]", field, f.GetKey())
+			}
+		}
+	}
+	return nil
+}
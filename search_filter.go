@@ -0,0 +1,145 @@
+package webquery
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// SearchFilter 取值的模糊匹配模式
+const (
+	SearchModeLike     = "LIKE"     // 默认，生成 %kw%
+	SearchModeLikeR    = "LIKER"    // 前缀匹配，生成 kw%，对索引更友好
+	SearchModeFullText = "FULLTEXT" // 使用数据库原生全文检索（MySQL MATCH...AGAINST / Postgres to_tsvector）
+)
+
+// SearchFilter 实现“一个搜索框查多列”的模糊检索：对 Key 传入的内容按空白切词（可通过
+// Tokenizer 自定义），词与词之间 AND，每个词在 Fields 范围内 OR —— 即每个关键字都必须
+// 命中 Fields 中至少一列才算匹配。
+type SearchFilter struct {
+	Key       string                // 前端传入参数名，如 q
+	Fields    []string              // 参与检索的数据库列
+	Mode      string                // SearchModeLike（默认）/ SearchModeLikeR / SearchModeFullText
+	Tokenizer func(string) []string // 自定义分词函数，默认按空白切词
+	MaxTokens int                   // 限制参与检索的关键字数量，<=0 表示不限制
+	tokens    []string              // 解析得到的关键字
+	parsed    bool                  // 是否解析过
+	Required  bool                  // 是否必选
+	ParseFunc ParseFunc             // 自定义转换函数
+	BindFunc  BindFunc              // 自定义绑定到查询条件
+}
+
+func (f *SearchFilter) GetKey() string {
+	return f.Key
+}
+
+func (f *SearchFilter) GetFields() []string {
+	return f.Fields
+}
+
+func (f *SearchFilter) GetMode() string {
+	if f.Mode == "" {
+		f.Mode = SearchModeLike
+	}
+	return strings.ToUpper(f.Mode)
+}
+
+// GetSymbol 恒定返回 LIKE，代表该过滤器以模糊匹配语义为基础，供 Schema 的运算符白名单校验使用
+func (f *SearchFilter) GetSymbol() string {
+	return SearchModeLike
+}
+
+func (f *SearchFilter) IsRequired() bool {
+	return f.Required
+}
+
+func (f *SearchFilter) GetValue() interface{} {
+	return f.tokens
+}
+
+func (f *SearchFilter) SetValue(value interface{}) {
+	f.tokens, _ = value.([]string)
+	f.parsed = true
+}
+
+func (f *SearchFilter) GetTokenizer() func(string) []string {
+	if f.Tokenizer == nil {
+		f.Tokenizer = strings.Fields
+	}
+	return f.Tokenizer
+}
+
+func (f *SearchFilter) Parse(c IQuery) error {
+	if f.ParseFunc != nil {
+		return f.ParseFunc(f, c)
+	}
+	value, err := parseValue(f, c)
+	if err != nil || value == "" {
+		return err
+	}
+	tokens := f.GetTokenizer()(value)
+	if f.MaxTokens > 0 && len(tokens) > f.MaxTokens {
+		tokens = tokens[:f.MaxTokens]
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	f.SetValue(tokens)
+	return nil
+}
+
+func (f *SearchFilter) Bind(db *gorm.DB) *gorm.DB {
+	if !f.parsed || len(f.tokens) == 0 || len(f.Fields) == 0 {
+		return db
+	}
+	if f.BindFunc != nil {
+		return f.BindFunc(f, db)
+	}
+	if f.GetMode() == SearchModeFullText {
+		return f.bindFullText(db)
+	}
+	return f.bindLike(db)
+}
+
+// bindLike 为每个 token 生成 (col1 LIKE ? OR col2 LIKE ? OR ...)，各 token 之间以 AND 拼接
+func (f *SearchFilter) bindLike(db *gorm.DB) *gorm.DB {
+	prefix := "%"
+	if f.GetMode() == SearchModeLikeR {
+		prefix = ""
+	}
+	for _, token := range f.tokens {
+		pattern := prefix + token + "%"
+		clauses := make([]string, len(f.Fields))
+		values := make([]interface{}, len(f.Fields))
+		for i, field := range f.Fields {
+			clauses[i] = field + " LIKE ?"
+			values[i] = pattern
+		}
+		db = db.Where(strings.Join(clauses, " OR "), values...)
+	}
+	return db
+}
+
+// bindFullText 依据 db.Dialector.Name() 选择方言原生的全文检索语法
+func (f *SearchFilter) bindFullText(db *gorm.DB) *gorm.DB {
+	dialect := ""
+	if db.Dialector != nil {
+		dialect = db.Dialector.Name()
+	}
+	for _, token := range f.tokens {
+		if dialect == "postgres" {
+			clauses := make([]string, len(f.Fields))
+			values := make([]interface{}, len(f.Fields))
+			for i, field := range f.Fields {
+				clauses[i] = "to_tsvector(" + field + ") @@ plainto_tsquery(?)"
+				values[i] = token
+			}
+			db = db.Where(strings.Join(clauses, " OR "), values...)
+			continue
+		}
+		columns := strings.Join(f.Fields, ",")
+		db = db.Where(fmt.Sprintf("MATCH(%s) AGAINST (? IN BOOLEAN MODE)", columns), token)
+	}
+	return db
+}
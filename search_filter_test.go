@@ -0,0 +1,142 @@
+package webquery
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+type searchFilterUser struct {
+	ID    uint
+	Email string
+	Phone string
+}
+
+func openSearchFilterTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	return db
+}
+
+// TestSearchFilter_Like 验证多个关键字之间以 AND 拼接，每个关键字在 Fields 内以 OR 拼接
+func TestSearchFilter_Like(t *testing.T) {
+	db := openSearchFilterTestDB(t)
+
+	f := &SearchFilter{Key: "q", Fields: []string{"email", "phone"}}
+	if err := f.Parse(mapQuery{"q": "foo bar"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	var users []searchFilterUser
+	tx := f.Bind(db.Session(&gorm.Session{DryRun: true}).Model(&searchFilterUser{})).Find(&users)
+
+	wantSQL := "SELECT * FROM `search_filter_users` WHERE (email LIKE ? OR phone LIKE ?) AND (email LIKE ? OR phone LIKE ?)"
+	if got := tx.Statement.SQL.String(); got != wantSQL {
+		t.Fatalf("SQL = %q, want %q", got, wantSQL)
+	}
+	wantVars := []interface{}{"%foo%", "%foo%", "%bar%", "%bar%"}
+	if got := tx.Statement.Vars; !varsEqual(got, wantVars) {
+		t.Fatalf("Vars = %v, want %v", got, wantVars)
+	}
+}
+
+// TestSearchFilter_LikeR 验证 LIKER 模式生成前缀匹配（kw%）而不是后缀/包含匹配
+func TestSearchFilter_LikeR(t *testing.T) {
+	db := openSearchFilterTestDB(t)
+
+	f := &SearchFilter{Key: "q", Fields: []string{"email"}, Mode: SearchModeLikeR}
+	if err := f.Parse(mapQuery{"q": "foo"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	var users []searchFilterUser
+	tx := f.Bind(db.Session(&gorm.Session{DryRun: true}).Model(&searchFilterUser{})).Find(&users)
+
+	wantVars := []interface{}{"foo%"}
+	if got := tx.Statement.Vars; !varsEqual(got, wantVars) {
+		t.Fatalf("Vars = %v, want %v", got, wantVars)
+	}
+}
+
+// TestSearchFilter_MaxTokens 验证 MaxTokens 截断参与检索的关键字数量
+func TestSearchFilter_MaxTokens(t *testing.T) {
+	db := openSearchFilterTestDB(t)
+
+	f := &SearchFilter{Key: "q", Fields: []string{"email"}, MaxTokens: 2}
+	if err := f.Parse(mapQuery{"q": "a b c d"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	var users []searchFilterUser
+	tx := f.Bind(db.Session(&gorm.Session{DryRun: true}).Model(&searchFilterUser{})).Find(&users)
+
+	wantVars := []interface{}{"%a%", "%b%"}
+	if got := tx.Statement.Vars; !varsEqual(got, wantVars) {
+		t.Fatalf("Vars = %v, want %v", got, wantVars)
+	}
+}
+
+// TestSearchFilter_FullText_Postgres 验证 postgres 方言下生成 to_tsvector/plainto_tsquery
+func TestSearchFilter_FullText_Postgres(t *testing.T) {
+	db := openSearchFilterTestDB(t)
+
+	f := &SearchFilter{Key: "q", Fields: []string{"email", "phone"}, Mode: SearchModeFullText}
+	if err := f.Parse(mapQuery{"q": "foo"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	tx := db.Session(&gorm.Session{DryRun: true}).Model(&searchFilterUser{})
+	tx.Statement.Dialector = fakeDialector{name: "postgres"}
+	var users []searchFilterUser
+	tx = f.Bind(tx).Find(&users)
+
+	wantSQL := "SELECT * FROM `search_filter_users` WHERE to_tsvector(email) @@ plainto_tsquery(?) OR to_tsvector(phone) @@ plainto_tsquery(?)"
+	if got := tx.Statement.SQL.String(); got != wantSQL {
+		t.Fatalf("SQL = %q, want %q", got, wantSQL)
+	}
+}
+
+// TestSearchFilter_FullText_MySQL 验证非 postgres 方言（默认 MySQL 语法）下生成 MATCH...AGAINST
+func TestSearchFilter_FullText_MySQL(t *testing.T) {
+	db := openSearchFilterTestDB(t)
+
+	f := &SearchFilter{Key: "q", Fields: []string{"email", "phone"}, Mode: SearchModeFullText}
+	if err := f.Parse(mapQuery{"q": "foo"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	var users []searchFilterUser
+	tx := f.Bind(db.Session(&gorm.Session{DryRun: true}).Model(&searchFilterUser{})).Find(&users)
+
+	wantSQL := "SELECT * FROM `search_filter_users` WHERE MATCH(email,phone) AGAINST (? IN BOOLEAN MODE)"
+	if got := tx.Statement.SQL.String(); got != wantSQL {
+		t.Fatalf("SQL = %q, want %q", got, wantSQL)
+	}
+}
+
+// fakeDialector 只用于在测试里覆盖 Dialector.Name()，驱动 bindFullText 的方言分支；
+// 其余方法均不会被 DryRun 场景用到
+type fakeDialector struct {
+	name string
+}
+
+func (d fakeDialector) Name() string                                   { return d.name }
+func (d fakeDialector) Initialize(*gorm.DB) error                      { return nil }
+func (d fakeDialector) Migrator(db *gorm.DB) gorm.Migrator             { return nil }
+func (d fakeDialector) DataTypeOf(*schema.Field) string                { return "" }
+func (d fakeDialector) DefaultValueOf(*schema.Field) clause.Expression { return nil }
+func (d fakeDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	writer.WriteByte('?')
+}
+func (d fakeDialector) QuoteTo(writer clause.Writer, str string) {
+	writer.WriteByte('`')
+	writer.WriteString(str)
+	writer.WriteByte('`')
+}
+func (d fakeDialector) Explain(sql string, vars ...interface{}) string { return sql }
@@ -0,0 +1,234 @@
+package webquery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const structTagName = "query"
+
+// parseTagOpts 解析形如 "name=status,field=orders.status,op=in,sep=|,required" 的 tag，
+// 每一项要么是 key=value，要么是裸标记（如 required、sort），裸标记取值固定为 "true"
+func parseTagOpts(tag string) map[string]string {
+	opts := make(map[string]string)
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if idx := strings.Index(part, "="); idx >= 0 {
+			opts[part[:idx]] = strings.TrimSpace(part[idx+1:])
+		} else {
+			opts[part] = "true"
+		}
+	}
+	return opts
+}
+
+// NewQueryFromStruct 通过反射解析结构体上的 query tag 构造 Filters/Sorts，
+// 免去逐个手写 Query.Filters 的注册代码。反射到的字段同时作为解析结果的类型化落点，
+// Parse(c) 之后可直接读取结构体字段获得过滤值；tag 为 "-" 的字段会被跳过。
+func NewQueryFromStruct(v any) (*Query, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, fmt.Errorf("webquery: NewQueryFromStruct requires a non-nil struct pointer")
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("webquery: NewQueryFromStruct requires a struct pointer")
+	}
+
+	q := &Query{}
+	groups := map[string]*GroupFilter{}
+	var groupOrder []string
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag, ok := sf.Tag.Lookup(structTagName)
+		if !ok || tag == "-" {
+			continue
+		}
+		opts := parseTagOpts(tag)
+
+		if _, isSort := opts["sort"]; isSort {
+			sort := Sort{Key: opts["key"], Field: opts["field"]}
+			if sort.Key == "" {
+				sort.Key = sf.Name
+			}
+			q.Sorts = append(q.Sorts, sort)
+			continue
+		}
+
+		filter, sync, err := buildFilterFromField(sf, rv.Field(i), opts)
+		if err != nil {
+			return nil, err
+		}
+		if sync != nil {
+			q.afterParse = append(q.afterParse, sync)
+		}
+
+		// group 把多个字段收进同一个 GroupFilter；组合子用独立的 group_op 键
+		// （而非 op），因为 op 已经是子过滤器自身比较运算符的 tag key，例如
+		// query:"name=email,op=like,group=advanced,group_op=or"
+		if groupName, inGroup := opts["group"]; inGroup {
+			g, exists := groups[groupName]
+			if !exists {
+				g = &GroupFilter{Key: groupName}
+				if op := opts["group_op"]; op != "" {
+					g.Combinator = op
+				}
+				groups[groupName] = g
+				groupOrder = append(groupOrder, groupName)
+			}
+			g.Children = append(g.Children, filter)
+			continue
+		}
+		q.Filters = append(q.Filters, filter)
+	}
+	for _, name := range groupOrder {
+		q.Filters = append(q.Filters, groups[name])
+	}
+	return q, nil
+}
+
+// MustBind 一步完成 NewQueryFromStruct + Parse，给 Gin handler 一个类似
+// ShouldBindQuery 的单行用法，但过滤条件支持 SQL 运算符与分隔符等扩展能力。
+func MustBind(c IQuery, v any) error {
+	q, err := NewQueryFromStruct(v)
+	if err != nil {
+		return err
+	}
+	return q.Parse(c)
+}
+
+// buildFilterFromField 依据字段类型与 tag 选项构造对应的 Filter，
+// 并返回一个在 Parse 成功后把解析值写回该字段的同步函数
+func buildFilterFromField(sf reflect.StructField, fv reflect.Value, opts map[string]string) (Filter, func(), error) {
+	key := opts["name"]
+	if key == "" {
+		key = sf.Name
+	}
+	symbol := opts["op"]
+	if symbol != "" && !IsValidOperator(symbol) {
+		return nil, nil, fmt.Errorf("webquery: field [%s] has illegal op [%s]", sf.Name, symbol)
+	}
+	_, required := opts["required"]
+	field := opts["field"]
+	sep := opts["sep"]
+	layout := opts["layout"]
+	typ := opts["type"]
+
+	switch typ {
+	case "timerange":
+		f := &TimeRangeFilter{Key: key, Field: field, Required: required, Layout: layout, Sep: sep}
+		return f, timeRangeSync(f, fv), nil
+	case "intrange":
+		f := &IntRangeFilter{Key: key, Field: field, Required: required, Sep: sep}
+		return f, intRangeSync(f, fv), nil
+	case "floatrange":
+		f := &FloatRangeFilter{Key: key, Field: field, Required: required, Sep: sep}
+		return f, floatRangeSync(f, fv), nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		f := &StringFilter{Key: key, Field: field, Symbol: symbol, Required: required}
+		return f, func() {
+			if fv.CanSet() {
+				fv.SetString(f.ParseValue)
+			}
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f := &IntFilter{Key: key, Field: field, Symbol: symbol, Required: required}
+		return f, func() {
+			if fv.CanSet() {
+				fv.SetInt(int64(f.ParseValue))
+			}
+		}, nil
+	case reflect.Bool:
+		f := &BoolFilter{Key: key, Field: field, Symbol: symbol, Required: required}
+		return f, func() {
+			if fv.CanSet() {
+				fv.SetBool(f.ParseValue)
+			}
+		}, nil
+	case reflect.Slice:
+		switch fv.Type().Elem().Kind() {
+		case reflect.String:
+			f := &StringArrayFilter{Key: key, Field: field, Symbol: symbol, Sep: sep, Required: required}
+			return f, func() {
+				if fv.CanSet() {
+					fv.Set(reflect.ValueOf(f.ParseValue))
+				}
+			}, nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f := &IntArrayFilter{Key: key, Field: field, Symbol: symbol, Sep: sep, Required: required}
+			return f, func() {
+				if !fv.CanSet() || f.ParseValue == nil {
+					return
+				}
+				// ParseValue 恒为 []int，逐个转换以兼容 []int8/[]int16/[]int32/[]int64 字段
+				out := reflect.MakeSlice(fv.Type(), len(f.ParseValue), len(f.ParseValue))
+				for i, n := range f.ParseValue {
+					out.Index(i).SetInt(int64(n))
+				}
+				fv.Set(out)
+			}, nil
+		}
+	case reflect.Struct:
+		if fv.Type() == reflect.TypeOf(time.Time{}) {
+			f := &TimeFilter{Key: key, Field: field, Symbol: symbol, Layout: layout, Required: required}
+			return f, func() {
+				if fv.CanSet() {
+					fv.Set(reflect.ValueOf(f.ParseValue))
+				}
+			}, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("webquery: field [%s] has an unsupported type %s for query tag", sf.Name, fv.Type())
+}
+
+func timeRangeSync(f *TimeRangeFilter, fv reflect.Value) func() {
+	if fv.Type() != reflect.TypeOf([2]time.Time{}) {
+		return nil
+	}
+	return func() {
+		if !fv.CanSet() {
+			return
+		}
+		_, start := f.getStart()
+		_, end := f.getEnd()
+		fv.Set(reflect.ValueOf([2]time.Time{start, end}))
+	}
+}
+
+func intRangeSync(f *IntRangeFilter, fv reflect.Value) func() {
+	if fv.Type() != reflect.TypeOf([2]int{}) {
+		return nil
+	}
+	return func() {
+		if !fv.CanSet() {
+			return
+		}
+		_, start := f.getStart()
+		_, end := f.getEnd()
+		fv.Set(reflect.ValueOf([2]int{start, end}))
+	}
+}
+
+func floatRangeSync(f *FloatRangeFilter, fv reflect.Value) func() {
+	if fv.Type() != reflect.TypeOf([2]float64{}) {
+		return nil
+	}
+	return func() {
+		if !fv.CanSet() {
+			return
+		}
+		_, start := f.getStart()
+		_, end := f.getEnd()
+		fv.Set(reflect.ValueOf([2]float64{start, end}))
+	}
+}
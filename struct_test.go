@@ -0,0 +1,124 @@
+package webquery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewQueryFromStruct_BasicTags(t *testing.T) {
+	type Req struct {
+		Name string   `query:"name=name,field=email,op=like,required"`
+		Age  int      `query:"name=age,op=>="`
+		Tags []string `query:"name=tags,sep=|"`
+		Sort string   `query:"sort,key=created_at,field=created_at"`
+	}
+
+	var req Req
+	q, err := NewQueryFromStruct(&req)
+	if err != nil {
+		t.Fatalf("NewQueryFromStruct: %v", err)
+	}
+	if len(q.Filters) != 3 {
+		t.Fatalf("expected 3 filters, got %d", len(q.Filters))
+	}
+	if len(q.Sorts) != 1 || q.Sorts[0].Key != "created_at" {
+		t.Fatalf("unexpected sorts: %+v", q.Sorts)
+	}
+
+	if err := q.Parse(mapQuery{"name": "bob", "age": "18", "tags": "a|b"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if req.Name != "bob" {
+		t.Fatalf("Name not synced back, got %q", req.Name)
+	}
+	if req.Age != 18 {
+		t.Fatalf("Age not synced back, got %d", req.Age)
+	}
+	if len(req.Tags) != 2 || req.Tags[0] != "a" || req.Tags[1] != "b" {
+		t.Fatalf("Tags not synced back, got %v", req.Tags)
+	}
+}
+
+// TestNewQueryFromStruct_GroupOp 验证 group 与子过滤器自身的 op 互不冲突：
+// group_op 控制组合子的组合方式，op 仍然是每个子过滤器自己的比较运算符
+// （此前复用同一个 op 键会导致 "or" 被当作子过滤器的比较运算符校验，报
+// "illegal op [or]"）
+func TestNewQueryFromStruct_GroupOp(t *testing.T) {
+	type Req struct {
+		Email string `query:"name=email,field=email,op=like,group=advanced,group_op=or"`
+		Phone string `query:"name=phone,field=phone,op=like,group=advanced,group_op=or"`
+	}
+
+	var req Req
+	q, err := NewQueryFromStruct(&req)
+	if err != nil {
+		t.Fatalf("NewQueryFromStruct: %v", err)
+	}
+	if len(q.Filters) != 1 {
+		t.Fatalf("expected filters to be collapsed into a single group, got %d", len(q.Filters))
+	}
+	group, ok := q.Filters[0].(*GroupFilter)
+	if !ok {
+		t.Fatalf("expected *GroupFilter, got %T", q.Filters[0])
+	}
+	if group.GetCombinator() != CombinatorOr {
+		t.Fatalf("combinator = %q, want OR", group.GetCombinator())
+	}
+	if len(group.Children) != 2 {
+		t.Fatalf("expected 2 children, got %d", len(group.Children))
+	}
+	for _, child := range group.Children {
+		sf, ok := child.(*StringFilter)
+		if !ok {
+			t.Fatalf("expected *StringFilter child, got %T", child)
+		}
+		if sf.GetSymbol() != "LIKE" {
+			t.Fatalf("child symbol = %q, want LIKE", sf.GetSymbol())
+		}
+	}
+}
+
+func TestNewQueryFromStruct_IllegalOp(t *testing.T) {
+	type Req struct {
+		Name string `query:"name=name,op=nope"`
+	}
+	var req Req
+	if _, err := NewQueryFromStruct(&req); err == nil {
+		t.Fatalf("expected error for illegal op")
+	}
+}
+
+func TestNewQueryFromStruct_TimeRangeSync(t *testing.T) {
+	type Req struct {
+		CreatedAt [2]time.Time `query:"name=created_at,type=timerange,layout=2006-01-02"`
+	}
+	var req Req
+	q, err := NewQueryFromStruct(&req)
+	if err != nil {
+		t.Fatalf("NewQueryFromStruct: %v", err)
+	}
+	if err := q.Parse(mapQuery{"created_at_start": "2024-01-01", "created_at_end": "2024-02-01"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if req.CreatedAt[0].IsZero() || req.CreatedAt[1].IsZero() {
+		t.Fatalf("expected CreatedAt synced back, got %+v", req.CreatedAt)
+	}
+}
+
+func TestMustBind(t *testing.T) {
+	type Req struct {
+		Name string `query:"name=name,required"`
+	}
+	var req Req
+	if err := MustBind(mapQuery{}, &req); err == nil {
+		t.Fatalf("expected required field to fail when missing")
+	}
+
+	var req2 Req
+	if err := MustBind(mapQuery{"name": "bob"}, &req2); err != nil {
+		t.Fatalf("MustBind: %v", err)
+	}
+	if req2.Name != "bob" {
+		t.Fatalf("Name = %q, want bob", req2.Name)
+	}
+}